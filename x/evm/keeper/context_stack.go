@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// cachedContext pairs a branched sdk.Context with the commit function CacheContext returned for it, so
+// the frame's writes - and any Cosmos events it emitted - can be propagated to its parent once the frame
+// is known to have succeeded.
+type cachedContext struct {
+	ctx    sdk.Context
+	commit func()
+}
+
+// ContextStack reproduces go-ethereum's Snapshot/RevertToSnapshot semantics on top of sdk.Context cache
+// branches. A single Ethereum transaction can make several nested sub-calls (CALL, CALLCODE,
+// DELEGATECALL, STATICCALL, contract creation); when one of them reverts, only the state and events it
+// produced - not those of its already-succeeded siblings - must be discarded. Because ctx.CacheContext()
+// gives every frame its own EventManager and only copies events into the parent on commit, dropping a
+// frame without committing it is enough to mirror go-ethereum's log revert behavior: reverted logs
+// disappear while sibling and ancestor events survive untouched.
+//
+// Keeper.EthereumTx owns exactly one ContextStack per execution and must assert it is empty again once
+// the tx finishes processing.
+type ContextStack struct {
+	frames []cachedContext
+}
+
+// NewContextStack returns a ContextStack rooted at ctx. The root frame has a no-op commit and is never
+// reverted; it is what a fully unwound stack commits into.
+func NewContextStack(ctx sdk.Context) *ContextStack {
+	return &ContextStack{
+		frames: []cachedContext{{ctx: ctx, commit: func() {}}},
+	}
+}
+
+// Snapshot branches a new cache context off the current top of the stack and pushes it, mirroring
+// go-ethereum's StateDB.Snapshot(). The returned index identifies the frame for RevertToSnapshot.
+func (cs *ContextStack) Snapshot() int {
+	cc, commit := cs.CurrentContext().CacheContext()
+	cs.frames = append(cs.frames, cachedContext{ctx: cc, commit: commit})
+	return len(cs.frames) - 1
+}
+
+// RevertToSnapshot discards the frame that the Snapshot() call returning rev pushed, along with every
+// frame pushed after it, without invoking any of their commit functions. It mirrors go-ethereum's
+// StateDB.RevertToSnapshot(rev), which undoes everything recorded since that Snapshot() call - rev itself
+// is not kept. rev is clamped to the stack's valid range ([1, len(frames)]) so an out-of-range value
+// (negative, stale, or from a different stack) can never panic on the slice; the root frame (index 0) is
+// never discarded.
+func (cs *ContextStack) RevertToSnapshot(rev int) {
+	if rev < 1 {
+		rev = 1
+	}
+	if rev > len(cs.frames) {
+		rev = len(cs.frames)
+	}
+	cs.frames = cs.frames[:rev]
+}
+
+// Commit invokes every frame's commit function in LIFO (tip-to-root) order, then collapses the stack back
+// to just its root frame. Frames are nested - Snapshot() always branches off the current top of the
+// stack, not off the root - so a frame's commit writes into its immediate parent's (still uncommitted)
+// cache store. Committing root-to-tip would flush an outer frame before its nested child's writes ever
+// reached it, silently losing the child's state and events; tip-to-root propagates each frame into its
+// parent before that parent is itself flushed one level further up.
+func (cs *ContextStack) Commit() {
+	for i := len(cs.frames) - 1; i >= 1; i-- {
+		cs.frames[i].commit()
+	}
+	cs.frames = cs.frames[:1]
+}
+
+// CurrentContext returns the context at the top of the stack, for use by StateDB operations and by gas
+// accounting (RefundGas, ResetGasMeterAndConsumeGas) while the current call frame is executing, instead
+// of a stale raw context captured before the innermost Snapshot().
+func (cs *ContextStack) CurrentContext() sdk.Context {
+	return cs.frames[len(cs.frames)-1].ctx
+}
+
+// IsEmpty reports whether the stack has unwound back to just its root frame.
+func (cs *ContextStack) IsEmpty() bool {
+	return len(cs.frames) == 1
+}
+
+// AssertEmpty returns an error if the stack still has frames left beyond its root. Keeper.EthereumTx
+// must call this once a transaction finishes processing: a non-empty stack means some call frame was
+// neither committed nor reverted, which would otherwise leak cached, unpropagated state.
+func (cs *ContextStack) AssertEmpty() error {
+	if !cs.IsEmpty() {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "context stack not empty after tx processing: %d frame(s) left", len(cs.frames)-1)
+	}
+	return nil
+}
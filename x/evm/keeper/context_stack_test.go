@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/evm/keeper"
+)
+
+func newStackTestContext() (sdk.Context, sdk.StoreKey) {
+	key := sdk.NewKVStoreKey("stack_test")
+	tkey := sdk.NewTransientStoreKey("stack_test_transient")
+	return testutil.DefaultContext(key, tkey), key
+}
+
+func TestContextStackCommitIsLIFOAndPropagatesToRoot(t *testing.T) {
+	root, key := newStackTestContext()
+	stack := keeper.NewContextStack(root)
+
+	stack.Snapshot()
+	stack.CurrentContext().KVStore(key).Set([]byte("outer"), []byte("1"))
+
+	stack.Snapshot()
+	stack.CurrentContext().KVStore(key).Set([]byte("inner"), []byte("2"))
+
+	stack.Commit()
+
+	require.True(t, stack.IsEmpty())
+	require.NoError(t, stack.AssertEmpty())
+	require.Equal(t, []byte("1"), root.KVStore(key).Get([]byte("outer")))
+	require.Equal(t, []byte("2"), root.KVStore(key).Get([]byte("inner")))
+}
+
+func TestContextStackRevertDropsOnlyRevertedFrame(t *testing.T) {
+	root, key := newStackTestContext()
+	stack := keeper.NewContextStack(root)
+
+	stack.Snapshot()
+	stack.CurrentContext().KVStore(key).Set([]byte("outer"), []byte("kept"))
+
+	rev := stack.Snapshot()
+	stack.CurrentContext().KVStore(key).Set([]byte("inner"), []byte("dropped"))
+
+	stack.RevertToSnapshot(rev)
+	stack.Commit()
+
+	require.True(t, stack.IsEmpty())
+	require.Equal(t, []byte("kept"), root.KVStore(key).Get([]byte("outer")))
+	require.Nil(t, root.KVStore(key).Get([]byte("inner")))
+}
+
+func TestContextStackAssertEmptyErrorsWhenFramesRemain(t *testing.T) {
+	root, _ := newStackTestContext()
+	stack := keeper.NewContextStack(root)
+	stack.Snapshot()
+
+	require.False(t, stack.IsEmpty())
+	require.Error(t, stack.AssertEmpty())
+}
+
+func TestContextStackRevertToSnapshotClampsOutOfRange(t *testing.T) {
+	root, _ := newStackTestContext()
+	stack := keeper.NewContextStack(root)
+	stack.Snapshot()
+
+	require.NotPanics(t, func() {
+		stack.RevertToSnapshot(-5)
+	})
+	require.True(t, stack.IsEmpty())
+
+	stack.Snapshot()
+	require.NotPanics(t, func() {
+		stack.RevertToSnapshot(100)
+	})
+}
@@ -14,7 +14,10 @@ import (
 )
 
 // RefundGasFn defines a custom gas refund function
-type RefundGasFn func(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error
+type RefundGasFn func(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string, baseFee *big.Int) error
+
+// BurnFeesFn defines a custom function that burns the base fee portion of the gas consumed by a message
+type BurnFeesFn func(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string, baseFee *big.Int) error
 
 // HasRefundGasFn a
 func (k Keeper) HasRefundGasFn() bool {
@@ -29,14 +32,43 @@ func (k *Keeper) SetRefundGasFn(fn RefundGasFn) {
 	k.refundGas = fn
 }
 
+// HasBurnFeesFn returns true if a custom fee burn handler has been registered
+func (k Keeper) HasBurnFeesFn() bool {
+	return k.burnFees != nil
+}
+
+// SetBurnFeesFn sets the fee burn logic used to dispose of the base fee portion of gas consumed.
+// Chains that want to redirect the burned amount (e.g. to a community pool split) can override it.
+func (k *Keeper) SetBurnFeesFn(fn BurnFeesFn) {
+	if k.HasBurnFeesFn() {
+		panic("fee burn handler already set")
+	}
+	k.burnFees = fn
+}
+
 // RefundGas defines the default RefundGasFn logic. It transfers the leftover gas to the sender of the
-// message, caped to half of the total gas consumed in the transaction. Additionally, the function sets
-// the total gas consumed to the value returned by the EVM execution, thus ignoring the previous
-// intrinsic gas consumed during in the AnteHandler.
+// message, exchanged at the effective gas price of the transaction. For dynamic fee (EIP-1559) txs the
+// effective price is baseFee plus the effective tip (the smaller of the tip cap and fee cap minus
+// baseFee); legacy and access-list txs keep being refunded at their flat gas price, which is what
+// msg.GasPrice() already returns when baseFee is nil.
 func (k *Keeper) RefundGas() RefundGasFn {
-	return func(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
-		// Return EVM tokens for remaining gas, exchanged at the original rate.
-		remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
+	return func(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string, baseFee *big.Int) error {
+		effectiveGasPrice := msg.GasPrice()
+
+		if baseFee != nil {
+			if msg.GasFeeCap().Cmp(baseFee) < 0 {
+				return sdkerrors.Wrapf(types.ErrInvalidBaseFee, "gas fee cap %s is lower than base fee %s", msg.GasFeeCap(), baseFee)
+			}
+
+			effectiveTip := msg.GasTipCap()
+			if feeCapTip := new(big.Int).Sub(msg.GasFeeCap(), baseFee); feeCapTip.Cmp(effectiveTip) < 0 {
+				effectiveTip = feeCapTip
+			}
+			effectiveGasPrice = new(big.Int).Add(effectiveTip, baseFee)
+		}
+
+		// Return EVM tokens for remaining gas, exchanged at the effective gas price.
+		remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), effectiveGasPrice)
 
 		switch remaining.Sign() {
 		case -1:
@@ -61,6 +93,49 @@ func (k *Keeper) RefundGas() RefundGasFn {
 	}
 }
 
+// BurnFees defines the default BurnFeesFn logic. It burns the base fee portion of the gas consumed by
+// the message (gasUsed * baseFee) from the fee collector module account, sending it through the evm
+// module account so it never reaches a validator. Pre-London chains, where baseFee is nil, burn nothing.
+func (k *Keeper) BurnFees() BurnFeesFn {
+	return func(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string, baseFee *big.Int) error {
+		if baseFee == nil {
+			return nil
+		}
+
+		burned := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), baseFee)
+		if burned.Sign() <= 0 {
+			return nil
+		}
+
+		burnedCoins := sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(burned))}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnedCoins); err != nil {
+			return sdkerrors.Wrapf(err, "fee collector account failed to escrow base fee for burning: %s", burnedCoins.String())
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoins); err != nil {
+			return sdkerrors.Wrapf(err, "failed to burn %d base fee (%s)", gasUsed, burnedCoins.String())
+		}
+
+		return nil
+	}
+}
+
+// RefundLeftoverGas invokes the configured RefundGasFn against ctx directly instead of whatever cached
+// context the EVM execution produced. The EVM run is wrapped in its own CacheContext so a reverted
+// message (out of gas, execution failure, explicit revert) can be discarded without rolling back Cosmos
+// state, but the leftover gas refund is not an EVM side effect - it must reach the sender regardless of
+// whether the call reverted. Callers must always pass the original, non-revertible sdk.Context here, never
+// the cached context the EVM run was executed against.
+func (k *Keeper) RefundLeftoverGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	if !k.HasRefundGasFn() {
+		return sdkerrors.Wrap(types.ErrInvalidRefund, "no refund gas handler registered")
+	}
+
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	return k.refundGas(ctx, msg, leftoverGas, denom, baseFee)
+}
+
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
 func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
 	height := big.NewInt(ctx.BlockHeight())
@@ -71,8 +146,18 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 }
 
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
-// 'gasUsed'
+// 'gasUsed'. Callers executing inside a snapshot (see ContextStack) must pass ctxStack.CurrentContext()
+// rather than a context captured before the current call frame's Snapshot(), so the gas meter being reset
+// is the one the in-flight EVM execution is actually consuming against.
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
+	if infGasMeter, ok := ctx.GasMeter().(*infiniteGasMeterWithLimit); ok {
+		// the bounded-infinite meter already tracks exactly what the EVM run consumed and never
+		// panics on refund/consume, so it can be overwritten directly instead of going through the
+		// refund-then-consume dance below, which exists to stay within a finite meter's limit.
+		infGasMeter.consumed = gasUsed
+		return
+	}
+
 	// reset the gas count
 	ctx.GasMeter().RefundGas(ctx.GasMeter().GasConsumed(), "reset the gas count")
 	ctx.GasMeter().ConsumeGas(gasUsed, "apply evm transaction")
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBurnFees(t *testing.T) {
+	gasUsed := uint64(21000)
+
+	testCases := []struct {
+		name      string
+		baseFee   *big.Int
+		expBurned sdk.Coins
+	}{
+		{
+			name:      "pre-London chain, nil base fee, no-op",
+			baseFee:   nil,
+			expBurned: nil,
+		},
+		{
+			name:      "zero base fee, no-op",
+			baseFee:   big.NewInt(0),
+			expBurned: nil,
+		},
+		{
+			name:      "positive base fee burns gasUsed * baseFee",
+			baseFee:   big.NewInt(10),
+			expBurned: sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(big.NewInt(10*21000)))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := &mockBankKeeper{}
+			k := newTestKeeper(bankKeeper, &mockFeeMarketKeeper{baseFee: tc.baseFee})
+			k.SetBurnFeesFn(k.BurnFees())
+
+			err := k.BurnFees()(newTestContext(), newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)), gasUsed, denom, tc.baseFee)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expBurned, bankKeeper.escrowedForBurn)
+			require.Equal(t, tc.expBurned, bankKeeper.burned)
+		})
+	}
+}
+
+// TestHasBurnFeesFn asserts the Has/Set accessor pair mirrors SetRefundGasFn's panic-on-double-set style.
+func TestHasBurnFeesFn(t *testing.T) {
+	k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+	require.False(t, k.HasBurnFeesFn())
+
+	k.SetBurnFeesFn(k.BurnFees())
+	require.True(t, k.HasBurnFeesFn())
+
+	require.Panics(t, func() {
+		k.SetBurnFeesFn(k.BurnFees())
+	})
+}
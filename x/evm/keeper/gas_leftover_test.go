@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/evm/keeper"
+)
+
+// TestRefundLeftoverGasNoHandler asserts that calling RefundLeftoverGas without a registered RefundGasFn
+// returns an error instead of panicking on a nil function call.
+func TestRefundLeftoverGasNoHandler(t *testing.T) {
+	k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+
+	err := k.RefundLeftoverGas(newTestContext(), newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)), 1000, denom)
+	require.Error(t, err)
+}
+
+// TestRefundLeftoverGasSurvivesRevert is a regression test for the revert case: it runs a simulated
+// deploy through a ContextStack frame (the same mechanism Keeper.EthereumTx uses), writes contract state
+// into that frame, then reverts it exactly as EthereumTx would for a failed deployment. It asserts both
+// that the reverted frame's writes never reach the root context, and - the actual regression being
+// guarded against - that calling RefundLeftoverGas against the root context afterwards still pays out the
+// sender's leftover gas refund, since the refund is not an EVM side effect and must survive regardless of
+// whether the call it paid for reverted.
+func TestRefundLeftoverGasSurvivesRevert(t *testing.T) {
+	root, key := newStackTestContext()
+
+	bankKeeper := &mockBankKeeper{}
+	k := newTestKeeper(bankKeeper, &mockFeeMarketKeeper{})
+	k.SetRefundGasFn(k.RefundGas())
+
+	stack := keeper.NewContextStack(root)
+	rev := stack.Snapshot()
+	stack.CurrentContext().KVStore(key).Set([]byte("contract-code"), []byte("deployed"))
+
+	// The deployment reverted: discard everything the frame wrote.
+	stack.RevertToSnapshot(rev)
+	require.NoError(t, stack.AssertEmpty())
+	require.Nil(t, root.KVStore(key).Get([]byte("contract-code")))
+
+	msg := newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100))
+	err := k.RefundLeftoverGas(root, msg, 1000, denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(big.NewInt(100*1000)))}, bankKeeper.sentToAccount)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+var _ sdk.GasMeter = (*infiniteGasMeterWithLimit)(nil)
+
+// infiniteGasMeterWithLimit is a sdk.GasMeter that never reports out of gas. It records GasConsumed the
+// way an ordinary metered gas meter would, but ConsumeGas always succeeds no matter how much has already
+// been consumed. Limit reports the gas limit the EVM message was submitted with, independent of whatever
+// the AnteHandler's intrinsic gas charge or the block gas meter happen to be tracking, so it cannot
+// spuriously abort an in-flight EVM run.
+type infiniteGasMeterWithLimit struct {
+	consumed uint64
+	limit    uint64
+}
+
+// NewInfiniteGasMeterWithLimit returns a new infinite gas meter bounded only in the sense that it
+// remembers limit (the tx gas limit) for reporting purposes. Keeper.EthereumTx swaps the incoming ctx's
+// gas meter for one of these for the duration of the EVM run, so block-gas accounting and AnteHandler
+// intrinsic gas cannot leak into EVM execution; the real gasUsed is read back via GasConsumed() once the
+// run finishes and charged against the original meter via ResetGasMeterAndConsumeGas.
+func NewInfiniteGasMeterWithLimit(limit uint64) sdk.GasMeter {
+	return &infiniteGasMeterWithLimit{
+		consumed: 0,
+		limit:    limit,
+	}
+}
+
+// GasConsumed returns the gas consumed so far from the tx.
+func (g *infiniteGasMeterWithLimit) GasConsumed() sdk.Gas {
+	return g.consumed
+}
+
+// GasConsumedToLimit returns the gas consumed so far from the tx; unlike a normal basic gas meter it does
+// not cap the returned value at the limit, since this meter never runs out of gas.
+func (g *infiniteGasMeterWithLimit) GasConsumedToLimit() sdk.Gas {
+	return g.consumed
+}
+
+// Limit returns the gas limit the EVM message was submitted with.
+func (g *infiniteGasMeterWithLimit) Limit() sdk.Gas {
+	return g.limit
+}
+
+// ConsumeGas adds amount to the gas consumed so far. It saturates at math.MaxUint64 instead of panicking
+// on overflow, and never reports out of gas.
+func (g *infiniteGasMeterWithLimit) ConsumeGas(amount sdk.Gas, _ string) {
+	sum := g.consumed + amount
+	if sum < g.consumed {
+		sum = math.MaxUint64
+	}
+	g.consumed = sum
+}
+
+// RefundGas deducts amount from the gas consumed so far, floored at zero. It never panics.
+func (g *infiniteGasMeterWithLimit) RefundGas(amount sdk.Gas, _ string) {
+	if amount > g.consumed {
+		g.consumed = 0
+		return
+	}
+	g.consumed -= amount
+}
+
+// IsPastLimit always returns false, since this meter never runs out of gas.
+func (g *infiniteGasMeterWithLimit) IsPastLimit() bool {
+	return false
+}
+
+// IsOutOfGas always returns false, since this meter never runs out of gas.
+func (g *infiniteGasMeterWithLimit) IsOutOfGas() bool {
+	return false
+}
+
+// String implements the Stringer interface.
+func (g *infiniteGasMeterWithLimit) String() string {
+	return fmt.Sprintf("InfiniteGasMeterWithLimit:\n  consumed: %d\n  limit: %d", g.consumed, g.limit)
+}
+
+// EthereumTx executes msg. It swaps ctx's gas meter for a bounded-infinite one limited to msg's gas limit
+// for the duration of the EVM run, so that intrinsic gas already charged against ctx in the AnteHandler,
+// and an ambient block gas meter running low, cannot spuriously abort execution partway through. Once the
+// run finishes, ResetGasMeterAndConsumeGas charges the real gasUsed it reported back against ctx's
+// original meter, so block gas accounting still sees accurate numbers. The swap applies the same way on
+// both the CheckTx and DeliverTx paths, since it only depends on msg and the gas meter ctx carries in.
+//
+// Execution runs against a dedicated ContextStack rooted at the gas-metered context: a successful message
+// commits its snapshot so state and events reach ctx, a reverted or errored message discards it instead.
+// AssertEmpty guards against a call frame that was neither committed nor reverted leaking cached state.
+//
+// Once execution and the gas meter reset are done, EthereumTx runs the post-execution fee path against
+// ctx (never the discarded EVM cache context): RefundLeftoverGas pays the sender back for unused gas, and
+// the registered BurnFeesFn disposes of the base fee portion of the gas that was consumed. Each step runs
+// only if its hook was registered (HasRefundGasFn/HasBurnFeesFn); otherwise it is skipped.
+func (k *Keeper) EthereumTx(ctx sdk.Context, msg core.Message) (*types.MsgEthereumTxResponse, error) {
+	infCtx := ctx.WithGasMeter(NewInfiniteGasMeterWithLimit(msg.Gas()))
+
+	stack := NewContextStack(infCtx)
+	rev := stack.Snapshot()
+
+	res, err := k.ApplyMessage(stack.CurrentContext(), msg, nil, true)
+	if err != nil || (res != nil && res.VmError != "") {
+		stack.RevertToSnapshot(rev)
+	} else {
+		stack.Commit()
+	}
+
+	if assertErr := stack.AssertEmpty(); assertErr != nil {
+		return nil, assertErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	k.ResetGasMeterAndConsumeGas(ctx, res.GasUsed)
+
+	denom := k.GetParams(ctx).EvmDenom
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+
+	if k.HasRefundGasFn() {
+		leftoverGas := msg.Gas() - res.GasUsed
+		if refundErr := k.RefundLeftoverGas(ctx, msg, leftoverGas, denom); refundErr != nil {
+			return nil, refundErr
+		}
+	}
+
+	if k.HasBurnFeesFn() {
+		if burnErr := k.burnFees(ctx, msg, res.GasUsed, denom, baseFee); burnErr != nil {
+			return nil, burnErr
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/evm/keeper"
+)
+
+func TestInfiniteGasMeterWithLimit(t *testing.T) {
+	limit := uint64(21000)
+	meter := keeper.NewInfiniteGasMeterWithLimit(limit)
+
+	require.Equal(t, sdk.Gas(limit), meter.Limit())
+	require.False(t, meter.IsOutOfGas())
+	require.False(t, meter.IsPastLimit())
+
+	// Consuming far more than the limit must not panic or report out of gas - this is the guarantee that
+	// keeps a low block gas meter or double-charged AnteHandler intrinsic gas from aborting an in-flight
+	// EVM run.
+	meter.ConsumeGas(limit*10, "evm execution")
+	require.Equal(t, sdk.Gas(limit*10), meter.GasConsumed())
+	require.False(t, meter.IsOutOfGas())
+	require.False(t, meter.IsPastLimit())
+
+	meter.RefundGas(limit*5, "leftover gas")
+	require.Equal(t, sdk.Gas(limit*5), meter.GasConsumed())
+
+	// Refunding more than has been consumed floors at zero instead of underflowing.
+	meter.RefundGas(limit*100, "over-refund")
+	require.Equal(t, sdk.Gas(0), meter.GasConsumed())
+}
+
+func TestInfiniteGasMeterWithLimitSaturatesOnOverflow(t *testing.T) {
+	meter := keeper.NewInfiniteGasMeterWithLimit(1)
+
+	meter.ConsumeGas(math.MaxUint64, "first")
+	meter.ConsumeGas(math.MaxUint64, "second")
+
+	require.Equal(t, sdk.Gas(math.MaxUint64), meter.GasConsumed())
+	require.False(t, meter.IsOutOfGas())
+}
+
+// TestOutOfGasWhenDeployContract exercises the meter-swap/charge-back pair Keeper.EthereumTx wires
+// together (NewInfiniteGasMeterWithLimit for the run, ResetGasMeterAndConsumeGas for the charge-back)
+// against a contract deployment that consumes more gas than the ambient block gas meter has left, on both
+// the DeliverTx and CheckTx paths. Deployment itself must not abort mid-execution; out-of-gas must only
+// surface once the real gas used is charged back against the original meter.
+func TestOutOfGasWhenDeployContract(t *testing.T) {
+	deployGasLimit := uint64(3_000_000)
+	deployGasUsed := uint64(2_500_000)
+	lowBlockGasLeft := uint64(1_000_000) // less than deployGasUsed - the scenario the swap must survive
+
+	testCases := []struct {
+		name    string
+		checkTx bool
+	}{
+		{name: "DeliverTx", checkTx: false},
+		{name: "CheckTx", checkTx: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blockCtx := sdk.NewContext(nil, tmproto.Header{}, tc.checkTx, log.NewNopLogger()).
+				WithGasMeter(sdk.NewGasMeter(lowBlockGasLeft))
+
+			infCtx := blockCtx.WithGasMeter(keeper.NewInfiniteGasMeterWithLimit(deployGasLimit))
+
+			require.NotPanics(t, func() {
+				infCtx.GasMeter().ConsumeGas(deployGasUsed, "deploy contract")
+			})
+			require.False(t, infCtx.GasMeter().IsOutOfGas())
+			require.Equal(t, sdk.Gas(deployGasUsed), infCtx.GasMeter().GasConsumed())
+
+			k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+
+			// Charging the real gasUsed back against the original (low) block gas meter is where
+			// out-of-gas is actually supposed to surface, on both block-processing paths.
+			require.Panics(t, func() {
+				k.ResetGasMeterAndConsumeGas(blockCtx, infCtx.GasMeter().GasConsumed())
+			})
+		})
+	}
+}
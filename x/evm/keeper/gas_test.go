@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/x/evm/keeper"
+)
+
+// mockBankKeeper records the coins it was asked to move or burn so tests can assert on the refunded and
+// burned amounts without standing up a full bank module.
+type mockBankKeeper struct {
+	sentToAccount   sdk.Coins
+	escrowedForBurn sdk.Coins
+	burned          sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+	m.sentToAccount = amt
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, _, _ string, amt sdk.Coins) error {
+	m.escrowedForBurn = amt
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	m.burned = amt
+	return nil
+}
+
+type mockFeeMarketKeeper struct {
+	baseFee *big.Int
+}
+
+func (m *mockFeeMarketKeeper) GetBaseFee(_ sdk.Context) *big.Int {
+	return m.baseFee
+}
+
+func newTestKeeper(bankKeeper *mockBankKeeper, feeMarketKeeper *mockFeeMarketKeeper) *keeper.Keeper {
+	return keeper.NewKeeper(nil, bankKeeper, feeMarketKeeper)
+}
+
+func newTestContext() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+}
+
+const denom = "aphoton"
+
+func newMessage(gasPrice, gasFeeCap, gasTipCap *big.Int) core.Message {
+	return core.NewMessage(
+		ethcommon.Address{},
+		nil,
+		0,
+		big.NewInt(0),
+		21000,
+		gasPrice,
+		gasFeeCap,
+		gasTipCap,
+		nil,
+		nil,
+		false,
+	)
+}
+
+func TestRefundGas(t *testing.T) {
+	leftoverGas := uint64(1000)
+
+	testCases := []struct {
+		name      string
+		msg       core.Message
+		baseFee   *big.Int
+		expRefund *big.Int
+		expErr    bool
+	}{
+		{
+			name:      "legacy tx, no base fee",
+			msg:       newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)),
+			baseFee:   nil,
+			expRefund: big.NewInt(100 * 1000),
+		},
+		{
+			name: "access-list tx, no base fee",
+			msg: core.NewMessage(
+				ethcommon.Address{}, nil, 0, big.NewInt(0), 21000,
+				big.NewInt(100), big.NewInt(100), big.NewInt(100),
+				nil, ethtypes.AccessList{{Address: ethcommon.Address{}, StorageKeys: []ethcommon.Hash{{}}}}, false,
+			),
+			baseFee:   nil,
+			expRefund: big.NewInt(100 * 1000),
+		},
+		{
+			name:      "dynamic-fee tx, tip cap is the binding constraint",
+			msg:       newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(20)),
+			baseFee:   big.NewInt(10),
+			expRefund: big.NewInt((20 + 10) * 1000), // effectiveTip=min(20, 100-10)=20
+		},
+		{
+			name:      "dynamic-fee tx, fee cap minus base fee is the binding constraint",
+			msg:       newMessage(big.NewInt(100), big.NewInt(30), big.NewInt(50)),
+			baseFee:   big.NewInt(10),
+			expRefund: big.NewInt((20 + 10) * 1000), // effectiveTip=min(50, 30-10)=20
+		},
+		{
+			name:    "gas fee cap below base fee errors",
+			msg:     newMessage(big.NewInt(100), big.NewInt(5), big.NewInt(5)),
+			baseFee: big.NewInt(10),
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := &mockBankKeeper{}
+			feeMarketKeeper := &mockFeeMarketKeeper{baseFee: tc.baseFee}
+			k := newTestKeeper(bankKeeper, feeMarketKeeper)
+			k.SetRefundGasFn(k.RefundGas())
+
+			err := k.RefundLeftoverGas(newTestContext(), tc.msg, leftoverGas, denom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(tc.expRefund))}, bankKeeper.sentToAccount)
+		})
+	}
+}
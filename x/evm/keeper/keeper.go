@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// Keeper grants access to the EVM module state. It wires in the Cosmos SDK keepers the EVM execution
+// path depends on (bank, fee market), plus the pluggable hooks - RefundGasFn, BurnFeesFn, TracerFn -
+// integrators can override via their respective SetXFn methods.
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	bankKeeper      types.BankKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+
+	// refundGas computes and pays back leftover gas to the message sender; see SetRefundGasFn.
+	refundGas RefundGasFn
+	// burnFees disposes of the base fee portion of gas consumed; see SetBurnFeesFn.
+	burnFees BurnFeesFn
+	// tracerFn builds a vm.EVMLogger for the currently executing message; see SetTracerFn.
+	tracerFn TracerFn
+}
+
+// NewKeeper creates a new x/evm Keeper instance, wiring in the expected bank and fee market keepers.
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	bankKeeper types.BankKeeper,
+	feeMarketKeeper types.FeeMarketKeeper,
+) *Keeper {
+	return &Keeper{
+		storeKey:        storeKey,
+		bankKeeper:      bankKeeper,
+		feeMarketKeeper: feeMarketKeeper,
+	}
+}
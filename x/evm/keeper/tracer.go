@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// TracerFn defines a hook that builds a vm.EVMLogger for a given message, following the same pattern as
+// RefundGasFn. It lets integrators attach a debug_traceTransaction-style struct logger, an access-list
+// tracer, or custom telemetry per message, without recompiling the chain.
+type TracerFn func(ctx sdk.Context, msg core.Message) vm.EVMLogger
+
+// HasTracerFn returns true if a custom tracer hook has been registered.
+func (k Keeper) HasTracerFn() bool {
+	return k.tracerFn != nil
+}
+
+// SetTracerFn sets the tracer hook used to build a vm.EVMLogger for each message.
+func (k *Keeper) SetTracerFn(fn TracerFn) {
+	if k.HasTracerFn() {
+		panic("tracer handler already set")
+	}
+	k.tracerFn = fn
+}
+
+// VMConfig returns cfg with Debug and Tracer populated from the registered TracerFn for msg. With no
+// tracer hook set it returns cfg unchanged, preserving today's behavior.
+func (k *Keeper) VMConfig(ctx sdk.Context, msg core.Message, cfg vm.Config) vm.Config {
+	if !k.HasTracerFn() {
+		return cfg
+	}
+
+	cfg.Debug = true
+	cfg.Tracer = k.tracerFn(ctx, msg)
+	return cfg
+}
+
+// TraceResult is the structured, callTracer-style output a tracer hands back to the caller. TraceTx
+// returns it directly; it is not attached to MsgEthereumTxResponse. It is only ever populated on the
+// query-only TraceTx path, never during normal message execution, so it cannot affect consensus.
+type TraceResult struct {
+	// Gas is the total gas used by the traced message.
+	Gas uint64 `json:"gas"`
+	// Failed indicates whether the traced execution reverted.
+	Failed bool `json:"failed"`
+	// ReturnValue is the hex-encoded return data of the traced execution.
+	ReturnValue string `json:"returnValue"`
+	// StructLogs holds the tracer's raw structured output (e.g. from vm.StructLogger), marshaled to JSON
+	// so the keeper does not need to depend on a concrete tracer implementation.
+	StructLogs json.RawMessage `json:"structLogs,omitempty"`
+}
+
+// TraceTx runs the EVM for msg against a snapshot of state, using the vm.EVMLogger tracerFn builds,
+// without mutating consensus state. This lets query handlers (debug_traceTransaction and friends) trace
+// historical or pending transactions. It branches a dedicated ContextStack frame for the run and always
+// discards it afterwards, regardless of whether the traced message succeeded or reverted.
+//
+// tracerFn may be nil, in which case - like every other HasXFn-guarded hook on Keeper - TraceTx falls
+// back to the registered TracerFn (see SetTracerFn) and errors if none was ever set, instead of panicking
+// on a nil call.
+func (k *Keeper) TraceTx(ctx sdk.Context, msg core.Message, tracerFn TracerFn) (*TraceResult, error) {
+	if tracerFn == nil {
+		if !k.HasTracerFn() {
+			return nil, sdkerrors.Wrap(types.ErrInvalidState, "no tracer hook registered or provided for TraceTx")
+		}
+		tracerFn = k.tracerFn
+	}
+
+	stack := NewContextStack(ctx)
+	rev := stack.Snapshot()
+	defer stack.RevertToSnapshot(rev)
+
+	traceCtx := stack.CurrentContext()
+	tracer := tracerFn(traceCtx, msg)
+
+	res, err := k.ApplyMessage(traceCtx, msg, tracer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	structLogs, err := marshalStructLogs(tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TraceResult{
+		Gas:         res.GasUsed,
+		Failed:      res.VmError != "",
+		ReturnValue: hexutil.Encode(res.Ret),
+		StructLogs:  structLogs,
+	}, nil
+}
+
+// marshalStructLogs extracts the callTracer-style structured output from tracer, if it is a
+// *vm.StructLogger (the struct logger go-ethereum's debug_traceTransaction uses by default). Tracers that
+// don't expose struct logs (e.g. a custom telemetry tracer) leave TraceResult.StructLogs empty rather than
+// erroring, since structured output is a bonus, not every TracerFn's purpose.
+func marshalStructLogs(tracer vm.EVMLogger) (json.RawMessage, error) {
+	structLogger, ok := tracer.(*vm.StructLogger)
+	if !ok {
+		return nil, nil
+	}
+
+	return json.Marshal(structLogger.StructLogs())
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func TestVMConfigNoTracerFn(t *testing.T) {
+	k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+	require.False(t, k.HasTracerFn())
+
+	cfg := k.VMConfig(newTestContext(), newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)), vm.Config{})
+	require.False(t, cfg.Debug)
+	require.Nil(t, cfg.Tracer)
+}
+
+func TestVMConfigWithTracerFn(t *testing.T) {
+	k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+	logger := vm.NewStructLogger(nil)
+	k.SetTracerFn(func(_ sdk.Context, _ core.Message) vm.EVMLogger {
+		return logger
+	})
+
+	cfg := k.VMConfig(newTestContext(), newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)), vm.Config{})
+	require.True(t, cfg.Debug)
+	require.Equal(t, vm.EVMLogger(logger), cfg.Tracer)
+}
+
+// TestTraceTxNilTracerFnNoHandler asserts that calling TraceTx with a nil tracerFn and no registered
+// TracerFn returns an error instead of panicking on the nil function call.
+func TestTraceTxNilTracerFnNoHandler(t *testing.T) {
+	k := newTestKeeper(&mockBankKeeper{}, &mockFeeMarketKeeper{})
+	require.False(t, k.HasTracerFn())
+
+	_, err := k.TraceTx(newTestContext(), newMessage(big.NewInt(100), big.NewInt(100), big.NewInt(100)), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/evm module sentinel errors.
+var (
+	// ErrInvalidRefund is returned when a computed gas refund amount is negative, or when a leftover gas
+	// refund is requested with no RefundGasFn registered.
+	ErrInvalidRefund = sdkerrors.Register(ModuleName, 2, "invalid refund")
+
+	// ErrInvalidBaseFee is returned when a message's fee cap is lower than the current base fee, which
+	// makes an EIP-1559 effective tip/gas price undefined.
+	ErrInvalidBaseFee = sdkerrors.Register(ModuleName, 3, "invalid base fee")
+
+	// ErrInvalidState is returned when EVM execution bookkeeping (e.g. the ContextStack) is left in an
+	// inconsistent state after a transaction finishes processing, or when TraceTx has no tracer hook to
+	// run with.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 4, "invalid state transition")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper interface used by the x/evm module to move and burn fees.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used by the x/evm module to look up
+// the current EIP-1559 base fee.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
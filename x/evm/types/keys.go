@@ -0,0 +1,7 @@
+package types
+
+const (
+	// ModuleName is the name of the EVM module, used as its store key and as the module account that
+	// escrows base fee amounts pending burn.
+	ModuleName = "evm"
+)